@@ -0,0 +1,23 @@
+package auth
+
+import "errors"
+
+// ErrInvalidCredentials is returned when a login's email/password pair
+// does not match a known user.
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+// ErrSessionNotFound is returned when a token has no matching session,
+// either because it never existed or was already revoked.
+var ErrSessionNotFound = errors.New("session not found")
+
+// ErrTokenExpired is returned by ValidateToken when the JWT's expiry has
+// passed.
+var ErrTokenExpired = errors.New("token expired")
+
+// ErrInvalidToken is returned by ValidateToken when the JWT is malformed
+// or fails signature verification, as distinct from ErrTokenExpired.
+var ErrInvalidToken = errors.New("invalid token")
+
+// ErrEmailTaken is returned by Register when an account already exists
+// for the given email.
+var ErrEmailTaken = errors.New("email already registered")
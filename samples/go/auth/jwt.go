@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// claims is the JWT payload. The subject is the user ID; sessions carry no
+// other identifying information so revoking the session store entry is
+// enough to invalidate them server-side.
+type claims struct {
+	jwt.RegisteredClaims
+}
+
+func signToken(userID string, ttl time.Duration, signingKey []byte) (string, time.Time, error) {
+	expiresAt := time.Now().Add(ttl)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	})
+
+	signed, err := token.SignedString(signingKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+func parseToken(tokenString string, signingKey []byte) (string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &claims{}, func(t *jwt.Token) (interface{}, error) {
+		return signingKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return "", ErrTokenExpired
+		}
+		return "", fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if !token.Valid {
+		return "", ErrInvalidToken
+	}
+
+	c, ok := token.Claims.(*claims)
+	if !ok {
+		return "", fmt.Errorf("unexpected claims type %T", token.Claims)
+	}
+	return c.Subject, nil
+}
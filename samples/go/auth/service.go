@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"analyzer/domain"
+	"analyzer/logging"
+)
+
+// Config configures the signing key and session lifetime used by
+// AuthService.
+type Config struct {
+	SigningKey []byte
+	TokenTTL   time.Duration
+}
+
+// AuthService handles registration, login, and session validation. It
+// sits alongside domain.UserService rather than replacing it: UserService
+// still owns user CRUD, AuthService owns the credential and session
+// lifecycle.
+type AuthService struct {
+	repository domain.UserRepository
+	sessions   SessionStore
+	logger     logging.Logger
+	config     Config
+}
+
+// NewAuthService creates a new AuthService.
+func NewAuthService(repo domain.UserRepository, sessions SessionStore, logger logging.Logger, config Config) *AuthService {
+	return &AuthService{
+		repository: repo,
+		sessions:   sessions,
+		logger:     logger,
+		config:     config,
+	}
+}
+
+// Register creates a new user with a bcrypt-hashed password.
+func (s *AuthService) Register(ctx context.Context, firstName, lastName, email, password string) (*domain.User, error) {
+	if _, err := s.repository.FindByEmail(email); err == nil {
+		return nil, ErrEmailTaken
+	} else if !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	user, err := domain.NewUser(firstName, lastName, email)
+	if err != nil {
+		return nil, err
+	}
+	user.PasswordHash = string(hash)
+
+	if err := s.repository.Save(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Login verifies credentials and issues a new session, returning the
+// signed JWT.
+func (s *AuthService) Login(ctx context.Context, email, password string) (string, error) {
+	user, err := s.repository.FindByEmail(email)
+	if err != nil {
+		s.logger.Error(ctx, "login failed", logging.F("email", email), logging.F("error", err))
+		return "", ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	token, expiresAt, err := signToken(user.ID, s.config.TokenTTL, s.config.SigningKey)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.sessions.Create(ctx, &Session{Token: token, UserID: user.ID, ExpiresAt: expiresAt}); err != nil {
+		return "", fmt.Errorf("create session: %w", err)
+	}
+
+	user.LastLoginAt = time.Now()
+	if err := s.repository.Save(user); err != nil {
+		return "", err
+	}
+
+	s.logger.Info(ctx, "user logged in", logging.F("user_id", user.ID))
+	return token, nil
+}
+
+// Logout revokes the session backing a token.
+func (s *AuthService) Logout(ctx context.Context, token string) error {
+	return s.sessions.Delete(ctx, token)
+}
+
+// ValidateToken verifies a JWT's signature and expiry, confirms its
+// session hasn't been revoked, and returns the user it belongs to.
+func (s *AuthService) ValidateToken(ctx context.Context, token string) (*domain.User, error) {
+	userID, err := parseToken(token, s.config.SigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessions.Get(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(session.ExpiresAt) {
+		return nil, ErrTokenExpired
+	}
+
+	return s.repository.FindByID(userID)
+}
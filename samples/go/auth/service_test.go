@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"analyzer/domain"
+	"analyzer/logging"
+)
+
+// fakeUserRepository is a minimal domain.UserRepository used to exercise
+// AuthService without a real database.
+type fakeUserRepository struct {
+	users map[string]*domain.User
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{users: make(map[string]*domain.User)}
+}
+
+func (r *fakeUserRepository) FindByID(id string) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) FindByEmail(email string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *fakeUserRepository) Save(user *domain.User) error {
+	r.users[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepository) Delete(id string) error {
+	if _, ok := r.users[id]; !ok {
+		return domain.ErrUserNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func (r *fakeUserRepository) List(offset, limit int) ([]*domain.User, int, error) {
+	all := make([]*domain.User, 0, len(r.users))
+	for _, user := range r.users {
+		all = append(all, user)
+	}
+	total := len(all)
+	if offset >= total {
+		return []*domain.User{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total, nil
+}
+
+func newTestAuthService() (*AuthService, *fakeUserRepository) {
+	repo := newFakeUserRepository()
+	service := NewAuthService(repo, NewInMemorySessionStore(), logging.NewTextLogger(), Config{
+		SigningKey: []byte("test-signing-key"),
+		TokenTTL:   time.Hour,
+	})
+	return service, repo
+}
+
+func TestAuthService_RegisterAndLogin(t *testing.T) {
+	service, _ := newTestAuthService()
+	ctx := context.Background()
+
+	if _, err := service.Register(ctx, "Ada", "Lovelace", "ada@example.com", "correct horse battery staple"); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	token, err := service.Login(ctx, "ada@example.com", "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("Login returned error: %v", err)
+	}
+	if token == "" {
+		t.Fatal("expected a non-empty token")
+	}
+
+	user, err := service.ValidateToken(ctx, token)
+	if err != nil {
+		t.Fatalf("ValidateToken returned error: %v", err)
+	}
+	if user.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want %q", user.Email, "ada@example.com")
+	}
+	if user.LastLoginAt.IsZero() {
+		t.Error("expected LastLoginAt to be set")
+	}
+}
+
+func TestAuthService_Register_DuplicateEmail(t *testing.T) {
+	service, _ := newTestAuthService()
+	ctx := context.Background()
+
+	if _, err := service.Register(ctx, "Ada", "Lovelace", "ada@example.com", "correct horse battery staple"); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	_, err := service.Register(ctx, "Ada", "King", "ada@example.com", "another password")
+	if !errors.Is(err, ErrEmailTaken) {
+		t.Fatalf("err = %v, want ErrEmailTaken", err)
+	}
+}
+
+func TestAuthService_Login_WrongPassword(t *testing.T) {
+	service, _ := newTestAuthService()
+	ctx := context.Background()
+
+	if _, err := service.Register(ctx, "Ada", "Lovelace", "ada@example.com", "correct horse battery staple"); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	_, err := service.Login(ctx, "ada@example.com", "wrong password")
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	service, _ := newTestAuthService()
+	ctx := context.Background()
+
+	if _, err := service.Register(ctx, "Ada", "Lovelace", "ada@example.com", "correct horse battery staple"); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+	token, err := service.Login(ctx, "ada@example.com", "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("Login returned error: %v", err)
+	}
+
+	if err := service.Logout(ctx, token); err != nil {
+		t.Fatalf("Logout returned error: %v", err)
+	}
+
+	if _, err := service.ValidateToken(ctx, token); !errors.Is(err, ErrSessionNotFound) {
+		t.Fatalf("err = %v, want ErrSessionNotFound", err)
+	}
+}
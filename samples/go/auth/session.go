@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Session is an issued login session backing a JWT. The token itself is
+// stateless; the store lets it be revoked before it would otherwise expire.
+type Session struct {
+	Token     string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// SessionStore persists issued sessions so they can be looked up or
+// revoked. InMemorySessionStore is the default; ValkeySessionStore backs
+// it with Valkey/Redis for deployments with more than one instance.
+type SessionStore interface {
+	Create(ctx context.Context, session *Session) error
+	Get(ctx context.Context, token string) (*Session, error)
+	Delete(ctx context.Context, token string) error
+}
+
+// InMemorySessionStore is a SessionStore backed by a map. It does not
+// survive process restarts and is not shared across instances, so it's
+// only suitable for local development and tests.
+type InMemorySessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+}
+
+// NewInMemorySessionStore creates an empty in-memory session store.
+func NewInMemorySessionStore() *InMemorySessionStore {
+	return &InMemorySessionStore{sessions: make(map[string]*Session)}
+}
+
+// Create stores a session.
+func (s *InMemorySessionStore) Create(_ context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[session.Token] = session
+	return nil
+}
+
+// Get retrieves a session by token.
+func (s *InMemorySessionStore) Get(_ context.Context, token string) (*Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	session, ok := s.sessions[token]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	return session, nil
+}
+
+// Delete revokes a session by token.
+func (s *InMemorySessionStore) Delete(_ context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, token)
+	return nil
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ValkeySessionStore is a SessionStore backed by Valkey (or any
+// Redis-protocol-compatible server), letting sessions be shared across
+// multiple instances and survive restarts.
+type ValkeySessionStore struct {
+	client *redis.Client
+}
+
+// NewValkeySessionStore wraps an existing client. Session keys are
+// namespaced under "session:" and expire automatically at the session's
+// ExpiresAt, so revocation on logout is a best-effort cleanup rather than
+// the only way a session goes away.
+func NewValkeySessionStore(client *redis.Client) *ValkeySessionStore {
+	return &ValkeySessionStore{client: client}
+}
+
+func sessionKey(token string) string {
+	return "session:" + token
+}
+
+// Create stores a session with a TTL matching its expiry.
+func (s *ValkeySessionStore) Create(ctx context.Context, session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+
+	ttl := time.Until(session.ExpiresAt)
+	if err := s.client.Set(ctx, sessionKey(session.Token), data, ttl).Err(); err != nil {
+		return fmt.Errorf("store session: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a session by token.
+func (s *ValkeySessionStore) Get(ctx context.Context, token string) (*Session, error) {
+	data, err := s.client.Get(ctx, sessionKey(token)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("unmarshal session: %w", err)
+	}
+	return &session, nil
+}
+
+// Delete revokes a session by token.
+func (s *ValkeySessionStore) Delete(ctx context.Context, token string) error {
+	if err := s.client.Del(ctx, sessionKey(token)).Err(); err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+	return nil
+}
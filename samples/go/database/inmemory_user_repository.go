@@ -0,0 +1,92 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"analyzer/domain"
+)
+
+// InMemoryUserRepository is a domain.UserRepository backed by a map. It's
+// handy for local development and as a lightweight stand-in in tests that
+// don't need a mock.
+type InMemoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[string]*domain.User
+}
+
+// NewInMemoryUserRepository creates an empty in-memory repository.
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{
+		users: make(map[string]*domain.User),
+	}
+}
+
+// FindByID finds a user by ID.
+func (r *InMemoryUserRepository) FindByID(id string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("find user %s: %w", id, domain.ErrUserNotFound)
+	}
+	return user, nil
+}
+
+// FindByEmail finds a user by email address.
+func (r *InMemoryUserRepository) FindByEmail(email string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("find user with email %s: %w", email, domain.ErrUserNotFound)
+}
+
+// Save saves a user, inserting it or overwriting the existing entry.
+func (r *InMemoryUserRepository) Save(user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[user.ID] = user
+	return nil
+}
+
+// Delete removes a user by ID.
+func (r *InMemoryUserRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("delete user %s: %w", id, domain.ErrUserNotFound)
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// List returns up to limit users starting at offset, ordered by CreatedAt.
+func (r *InMemoryUserRepository) List(offset, limit int) ([]*domain.User, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	all := make([]*domain.User, 0, len(r.users))
+	for _, user := range r.users {
+		all = append(all, user)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].CreatedAt.Before(all[j].CreatedAt) })
+
+	total := len(all)
+	if offset >= total {
+		return []*domain.User{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total, nil
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"analyzer/domain"
+)
+
+// SQLUserRepository is a domain.UserRepository backed by a SQL database.
+// Queries are written by hand here in the style sqlc would generate them,
+// so the mapping between SQL and Go stays easy to follow.
+type SQLUserRepository struct {
+	db *sql.DB
+}
+
+// NewSQLUserRepository wraps an existing connection pool. Callers are
+// responsible for sizing the pool (db.SetMaxOpenConns, SetMaxIdleConns,
+// SetConnMaxLifetime) before handing it over, since those limits depend on
+// the deployment rather than the repository itself.
+func NewSQLUserRepository(db *sql.DB) *SQLUserRepository {
+	return &SQLUserRepository{db: db}
+}
+
+const selectUserColumns = `id, first_name, last_name, email, password_hash, email_verified, last_login_at, created_at, updated_at`
+
+// FindByID finds a user by ID.
+func (r *SQLUserRepository) FindByID(id string) (*domain.User, error) {
+	row := r.db.QueryRow(`SELECT `+selectUserColumns+` FROM users WHERE id = $1`, id)
+	return scanUser(row)
+}
+
+// FindByEmail finds a user by email address.
+func (r *SQLUserRepository) FindByEmail(email string) (*domain.User, error) {
+	row := r.db.QueryRow(`SELECT `+selectUserColumns+` FROM users WHERE email = $1`, email)
+	return scanUser(row)
+}
+
+// Save upserts a user.
+func (r *SQLUserRepository) Save(user *domain.User) error {
+	var lastLoginAt sql.NullTime
+	if !user.LastLoginAt.IsZero() {
+		lastLoginAt = sql.NullTime{Time: user.LastLoginAt, Valid: true}
+	}
+
+	_, err := r.db.Exec(`
+		INSERT INTO users (id, first_name, last_name, email, password_hash, email_verified, last_login_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE
+		SET first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name,
+		    email = EXCLUDED.email, password_hash = EXCLUDED.password_hash,
+		    email_verified = EXCLUDED.email_verified, last_login_at = EXCLUDED.last_login_at,
+		    updated_at = EXCLUDED.updated_at`,
+		user.ID, user.FirstName, user.LastName, user.Email, user.PasswordHash,
+		user.EmailVerified, lastLoginAt, user.CreatedAt, user.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("save user %s: %w", user.ID, err)
+	}
+	return nil
+}
+
+// Delete removes a user by ID.
+func (r *SQLUserRepository) Delete(id string) error {
+	result, err := r.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete user %s: %w", id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete user %s: %w", id, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("delete user %s: %w", id, domain.ErrUserNotFound)
+	}
+	return nil
+}
+
+// List returns up to limit users starting at offset, ordered by CreatedAt,
+// along with the total number of users in the table.
+func (r *SQLUserRepository) List(offset, limit int) ([]*domain.User, int, error) {
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count users: %w", err)
+	}
+
+	rows, err := r.db.Query(`SELECT `+selectUserColumns+` FROM users ORDER BY created_at LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*domain.User, 0, limit)
+	for rows.Next() {
+		user, err := scanUserRow(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("list users: %w", err)
+	}
+	return users, total, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows, letting scanUser
+// and scanUserRow share the same field list.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUserRow(row rowScanner) (*domain.User, error) {
+	var (
+		user        domain.User
+		lastLoginAt sql.NullTime
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+	if err := row.Scan(
+		&user.ID, &user.FirstName, &user.LastName, &user.Email,
+		&user.PasswordHash, &user.EmailVerified, &lastLoginAt,
+		&createdAt, &updatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("scan user: %w", err)
+	}
+	if lastLoginAt.Valid {
+		user.LastLoginAt = lastLoginAt.Time
+	}
+	user.CreatedAt = createdAt
+	user.UpdatedAt = updatedAt
+	return &user, nil
+}
+
+func scanUser(row *sql.Row) (*domain.User, error) {
+	user, err := scanUserRow(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
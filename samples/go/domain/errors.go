@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+// ErrUserNotFound is returned by UserRepository implementations when no
+// matching user exists.
+var ErrUserNotFound = errors.New("user not found")
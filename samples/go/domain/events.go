@@ -0,0 +1,12 @@
+package domain
+
+// UserCreatedTopic is the event.Bus topic published to whenever
+// UserService.CreateUser creates a new user.
+const UserCreatedTopic = "user.created"
+
+// UserCreatedEvent is the payload published on UserCreatedTopic.
+type UserCreatedEvent struct {
+	UserID    string `json:"user_id"`
+	FirstName string `json:"first_name"`
+	Email     string `json:"email"`
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "fmt"
+
+// mockUserRepository is an in-memory UserRepository double used so
+// UserService tests don't need a live database. It also lets tests force
+// errors via findErr/saveErr.
+type mockUserRepository struct {
+	users     map[string]*User
+	findErr   error
+	saveErr   error
+	saveCalls int
+}
+
+func newMockUserRepository() *mockUserRepository {
+	return &mockUserRepository{users: make(map[string]*User)}
+}
+
+func (m *mockUserRepository) FindByID(id string) (*User, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	user, ok := m.users[id]
+	if !ok {
+		return nil, fmt.Errorf("find user %s: %w", id, ErrUserNotFound)
+	}
+	return user, nil
+}
+
+func (m *mockUserRepository) FindByEmail(email string) (*User, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	for _, user := range m.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("find user with email %s: %w", email, ErrUserNotFound)
+}
+
+func (m *mockUserRepository) Save(user *User) error {
+	m.saveCalls++
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.users[user.ID] = user
+	return nil
+}
+
+func (m *mockUserRepository) Delete(id string) error {
+	if _, ok := m.users[id]; !ok {
+		return fmt.Errorf("delete user %s: %w", id, ErrUserNotFound)
+	}
+	delete(m.users, id)
+	return nil
+}
+
+func (m *mockUserRepository) List(offset, limit int) ([]*User, int, error) {
+	all := make([]*User, 0, len(m.users))
+	for _, user := range m.users {
+		all = append(all, user)
+	}
+	total := len(all)
+	if offset >= total {
+		return []*User{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total, nil
+}
@@ -0,0 +1,15 @@
+package domain
+
+// UserRepository handles user data storage. Concrete implementations live
+// in the database package, which can back it with anything from an
+// in-memory map to a SQL database.
+type UserRepository interface {
+	FindByID(id string) (*User, error)
+	FindByEmail(email string) (*User, error)
+	Save(user *User) error
+	Delete(id string) error
+	// List returns up to limit users starting at offset, ordered by
+	// CreatedAt, along with the total number of users available. It backs
+	// paginated listing in the transport layer.
+	List(offset, limit int) ([]*User, int, error)
+}
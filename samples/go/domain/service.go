@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"analyzer/event"
+	"analyzer/logging"
+)
+
+var tracer = otel.Tracer("analyzer/domain")
+
+// UserService is the domain service for user-related operations. It knows
+// nothing about DTOs or transport; the usecase package wraps it to expose
+// an application-level API.
+type UserService struct {
+	repository UserRepository
+	logger     logging.Logger
+	bus        event.Bus
+}
+
+// NewUserService creates a new UserService
+func NewUserService(repo UserRepository, logger logging.Logger, bus event.Bus) *UserService {
+	return &UserService{
+		repository: repo,
+		logger:     logger,
+		bus:        bus,
+	}
+}
+
+// GetUser retrieves a user by ID
+func (s *UserService) GetUser(ctx context.Context, id string) (*User, error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetUser")
+	defer span.End()
+
+	s.logger.Info(ctx, "fetching user", logging.F("user_id", id))
+	user, err := s.repository.FindByID(id)
+	if err != nil {
+		s.logger.Error(ctx, "error fetching user", logging.F("user_id", id), logging.F("error", err))
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateUser creates a new user
+func (s *UserService) CreateUser(ctx context.Context, firstName, lastName, email string) (*User, error) {
+	ctx, span := tracer.Start(ctx, "UserService.CreateUser")
+	defer span.End()
+
+	user, err := NewUser(firstName, lastName, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.Save(user); err != nil {
+		return nil, err
+	}
+
+	published := event.Event{
+		Topic: UserCreatedTopic,
+		Payload: UserCreatedEvent{
+			UserID:    user.ID,
+			FirstName: user.FirstName,
+			Email:     user.Email,
+		},
+	}
+	if err := s.bus.Publish(ctx, published); err != nil {
+		s.logger.Warn(ctx, "failed to publish user created event", logging.F("user_id", user.ID), logging.F("error", err))
+	}
+	return user, nil
+}
+
+// UpdateUser updates an existing user
+func (s *UserService) UpdateUser(ctx context.Context, id, firstName, lastName, email string) (*User, error) {
+	ctx, span := tracer.Start(ctx, "UserService.UpdateUser")
+	defer span.End()
+
+	user, err := s.repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.Rename(firstName, lastName); err != nil {
+		return nil, err
+	}
+	if err := user.ChangeEmail(email); err != nil {
+		return nil, err
+	}
+	user.UpdatedAt = time.Now()
+
+	if err := s.repository.Save(user); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "updated user", logging.F("user_id", id))
+	return user, nil
+}
+
+// DeleteUser removes a user by ID.
+func (s *UserService) DeleteUser(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "UserService.DeleteUser")
+	defer span.End()
+
+	if err := s.repository.Delete(id); err != nil {
+		s.logger.Error(ctx, "error deleting user", logging.F("user_id", id), logging.F("error", err))
+		return err
+	}
+	return nil
+}
+
+// ListUsers returns a page of users along with the total number available.
+func (s *UserService) ListUsers(ctx context.Context, offset, limit int) ([]*User, int, error) {
+	_, span := tracer.Start(ctx, "UserService.ListUsers")
+	defer span.End()
+
+	return s.repository.List(offset, limit)
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"analyzer/event"
+	"analyzer/logging"
+)
+
+func TestUserService_GetUser(t *testing.T) {
+	repo := newMockUserRepository()
+	repo.users["user-1"] = &User{ID: "user-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	user, err := service.GetUser(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("GetUser returned error: %v", err)
+	}
+	if user.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want %q", user.Email, "ada@example.com")
+	}
+}
+
+func TestUserService_GetUser_NotFound(t *testing.T) {
+	repo := newMockUserRepository()
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	_, err := service.GetUser(context.Background(), "missing")
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("err = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestUserService_CreateUser(t *testing.T) {
+	repo := newMockUserRepository()
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	user, err := service.CreateUser(context.Background(), "Grace", "Hopper", "grace@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+	if repo.saveCalls != 1 {
+		t.Errorf("saveCalls = %d, want 1", repo.saveCalls)
+	}
+	if _, ok := repo.users[user.ID]; !ok {
+		t.Errorf("created user %s was not persisted", user.ID)
+	}
+}
+
+func TestUserService_CreateUser_InvalidInput(t *testing.T) {
+	repo := newMockUserRepository()
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	_, err := service.CreateUser(context.Background(), "", "Hopper", "grace@example.com")
+	if !errors.Is(err, ErrInvalidName) {
+		t.Fatalf("err = %v, want ErrInvalidName", err)
+	}
+	if repo.saveCalls != 0 {
+		t.Errorf("saveCalls = %d, want 0", repo.saveCalls)
+	}
+}
+
+func TestUserService_UpdateUser(t *testing.T) {
+	repo := newMockUserRepository()
+	repo.users["user-1"] = &User{ID: "user-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	updated, err := service.UpdateUser(context.Background(), "user-1", "Ada", "King", "ada.king@example.com")
+	if err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+	if updated.LastName != "King" {
+		t.Errorf("LastName = %q, want %q", updated.LastName, "King")
+	}
+}
+
+func TestUserService_UpdateUser_InvalidInput(t *testing.T) {
+	repo := newMockUserRepository()
+	repo.users["user-1"] = &User{ID: "user-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	_, err := service.UpdateUser(context.Background(), "user-1", "Ada", "Lovelace", "not-an-email")
+	if !errors.Is(err, ErrInvalidEmail) {
+		t.Fatalf("err = %v, want ErrInvalidEmail", err)
+	}
+	if repo.users["user-1"].Email != "ada@example.com" {
+		t.Errorf("Email = %q, want unchanged %q", repo.users["user-1"].Email, "ada@example.com")
+	}
+}
+
+func TestUserService_DeleteUser(t *testing.T) {
+	repo := newMockUserRepository()
+	repo.users["user-1"] = &User{ID: "user-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	if err := service.DeleteUser(context.Background(), "user-1"); err != nil {
+		t.Fatalf("DeleteUser returned error: %v", err)
+	}
+	if _, ok := repo.users["user-1"]; ok {
+		t.Error("expected user-1 to be deleted")
+	}
+}
+
+func TestUserService_ListUsers(t *testing.T) {
+	repo := newMockUserRepository()
+	repo.users["user-1"] = &User{ID: "user-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+	repo.users["user-2"] = &User{ID: "user-2", FirstName: "Grace", LastName: "Hopper", Email: "grace@example.com"}
+	service := NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+
+	users, total, err := service.ListUsers(context.Background(), 0, 10)
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if total != 2 || len(users) != 2 {
+		t.Errorf("got %d users (total %d), want 2 (total 2)", len(users), total)
+	}
+}
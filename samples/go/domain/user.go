@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	minNameLength = 1
+	maxNameLength = 100
+)
+
+// ErrInvalidName is returned when a first or last name fails validation.
+var ErrInvalidName = errors.New("name must be between 1 and 100 characters")
+
+// ErrInvalidEmail is returned when an email address is not well-formed.
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// User represents a user in the system.
+type User struct {
+	ID            string
+	FirstName     string
+	LastName      string
+	Email         string
+	PasswordHash  string
+	EmailVerified bool
+	LastLoginAt   time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewUser constructs a User, validating the name and email and assigning
+// it a fresh ULID. Repositories reconstruct existing users directly from
+// storage rather than going through this constructor.
+func NewUser(firstName, lastName, email string) (*User, error) {
+	if err := validateName(firstName); err != nil {
+		return nil, fmt.Errorf("first name %q: %w", firstName, err)
+	}
+	if err := validateName(lastName); err != nil {
+		return nil, fmt.Errorf("last name %q: %w", lastName, err)
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, fmt.Errorf("%s: %w", email, ErrInvalidEmail)
+	}
+
+	now := time.Now()
+	return &User{
+		ID:        ulid.Make().String(),
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     email,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// FullName returns the user's display name.
+func (u *User) FullName() string {
+	return u.FirstName + " " + u.LastName
+}
+
+// Rename updates the user's first and last name, validating both the
+// same way NewUser does.
+func (u *User) Rename(firstName, lastName string) error {
+	if err := validateName(firstName); err != nil {
+		return fmt.Errorf("first name %q: %w", firstName, err)
+	}
+	if err := validateName(lastName); err != nil {
+		return fmt.Errorf("last name %q: %w", lastName, err)
+	}
+	u.FirstName = firstName
+	u.LastName = lastName
+	return nil
+}
+
+// ChangeEmail updates the user's email, validating it the same way
+// NewUser does.
+func (u *User) ChangeEmail(email string) error {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return fmt.Errorf("%s: %w", email, ErrInvalidEmail)
+	}
+	u.Email = email
+	return nil
+}
+
+func validateName(name string) error {
+	if n := utf8.RuneCountInString(name); n < minNameLength || n > maxNameLength {
+		return ErrInvalidName
+	}
+	return nil
+}
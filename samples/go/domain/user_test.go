@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewUser(t *testing.T) {
+	user, err := NewUser("Ada", "Lovelace", "ada@example.com")
+	if err != nil {
+		t.Fatalf("NewUser returned error: %v", err)
+	}
+	if user.ID == "" {
+		t.Error("expected a generated ID")
+	}
+	if user.FullName() != "Ada Lovelace" {
+		t.Errorf("FullName() = %q, want %q", user.FullName(), "Ada Lovelace")
+	}
+}
+
+func TestNewUser_InvalidName(t *testing.T) {
+	_, err := NewUser("", "Lovelace", "ada@example.com")
+	if !errors.Is(err, ErrInvalidName) {
+		t.Fatalf("err = %v, want ErrInvalidName", err)
+	}
+
+	_, err = NewUser(strings.Repeat("a", maxNameLength+1), "Lovelace", "ada@example.com")
+	if !errors.Is(err, ErrInvalidName) {
+		t.Fatalf("err = %v, want ErrInvalidName", err)
+	}
+}
+
+func TestNewUser_InvalidEmail(t *testing.T) {
+	_, err := NewUser("Ada", "Lovelace", "not-an-email")
+	if !errors.Is(err, ErrInvalidEmail) {
+		t.Fatalf("err = %v, want ErrInvalidEmail", err)
+	}
+}
@@ -0,0 +1,30 @@
+// Package event decouples producers of domain occurrences from the
+// handlers that react to them. A producer publishes an Event by topic
+// without knowing who, if anyone, is listening; subscribers register
+// independently, so handlers like audit logging or analytics can be added
+// without changing the producer.
+package event
+
+import "context"
+
+// Event is a message published on a Bus. Topic identifies the kind of
+// event; Payload carries event-specific data understood by subscribers.
+// Payload is a concrete struct when delivered by an in-process Bus, and
+// may be a json.RawMessage when delivered by a Bus that crosses process
+// boundaries (e.g. NSQBus) — handlers decode it accordingly.
+type Event struct {
+	Topic   string
+	Payload any
+}
+
+// Handler processes an Event delivered on a subscribed topic.
+type Handler func(ctx context.Context, evt Event) error
+
+// Bus publishes events to subscribers by topic.
+type Bus interface {
+	// Publish delivers evt to every handler subscribed to evt.Topic.
+	Publish(ctx context.Context, evt Event) error
+	// Subscribe registers handler to be called for every Event published
+	// to topic.
+	Subscribe(topic string, handler Handler)
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"context"
+	"sync"
+)
+
+// InMemoryBus dispatches events to subscribers in-process. It's the
+// default Bus for tests and single-process deployments.
+type InMemoryBus struct {
+	mu          sync.RWMutex
+	subscribers map[string][]Handler
+}
+
+// NewInMemoryBus creates a new InMemoryBus.
+func NewInMemoryBus() *InMemoryBus {
+	return &InMemoryBus{subscribers: make(map[string][]Handler)}
+}
+
+// Publish calls every handler subscribed to evt.Topic, in subscription
+// order. It calls every handler even if one fails, and returns the first
+// error encountered, if any.
+func (b *InMemoryBus) Publish(ctx context.Context, evt Event) error {
+	b.mu.RLock()
+	handlers := append([]Handler(nil), b.subscribers[evt.Topic]...)
+	b.mu.RUnlock()
+
+	var firstErr error
+	for _, handler := range handlers {
+		if err := handler(ctx, evt); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Subscribe registers handler to be called for every Event published to
+// topic.
+func (b *InMemoryBus) Subscribe(topic string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[topic] = append(b.subscribers[topic], handler)
+}
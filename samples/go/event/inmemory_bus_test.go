@@ -0,0 +1,101 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestInMemoryBus_PublishDeliversToSubscribers(t *testing.T) {
+	bus := NewInMemoryBus()
+
+	var got Event
+	bus.Subscribe("topic.a", func(ctx context.Context, evt Event) error {
+		got = evt
+		return nil
+	})
+
+	want := Event{Topic: "topic.a", Payload: "payload"}
+	if err := bus.Publish(context.Background(), want); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+	if got != want {
+		t.Errorf("handler received %+v, want %+v", got, want)
+	}
+}
+
+func TestInMemoryBus_PublishIgnoresOtherTopics(t *testing.T) {
+	bus := NewInMemoryBus()
+
+	called := false
+	bus.Subscribe("topic.a", func(ctx context.Context, evt Event) error {
+		called = true
+		return nil
+	})
+
+	if err := bus.Publish(context.Background(), Event{Topic: "topic.b"}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+	if called {
+		t.Error("handler subscribed to topic.a was called for topic.b")
+	}
+}
+
+func TestInMemoryBus_PublishReturnsFirstErrorButCallsAllHandlers(t *testing.T) {
+	bus := NewInMemoryBus()
+
+	errBoom := errors.New("boom")
+	calls := 0
+	bus.Subscribe("topic.a", func(ctx context.Context, evt Event) error {
+		calls++
+		return errBoom
+	})
+	bus.Subscribe("topic.a", func(ctx context.Context, evt Event) error {
+		calls++
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), Event{Topic: "topic.a"})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("err = %v, want %v", err, errBoom)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestRetry_SucceedsAfterTransientFailures(t *testing.T) {
+	attempts := 0
+	handler := Retry(func(ctx context.Context, evt Event) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	}, RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	if err := handler(context.Background(), Event{Topic: "topic.a"}); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRetry_ReturnsLastErrorAfterExhaustingAttempts(t *testing.T) {
+	errBoom := errors.New("boom")
+	attempts := 0
+	handler := Retry(func(ctx context.Context, evt Event) error {
+		attempts++
+		return errBoom
+	}, RetryConfig{MaxAttempts: 2, BaseDelay: time.Millisecond})
+
+	err := handler(context.Background(), Event{Topic: "topic.a"})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("err = %v, want %v", err, errBoom)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
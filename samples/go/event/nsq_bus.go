@@ -0,0 +1,85 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/nsqio/go-nsq"
+
+	"analyzer/logging"
+)
+
+// NSQBus is a Bus backed by NSQ, letting events cross process boundaries
+// instead of staying in-memory.
+type NSQBus struct {
+	producer    *nsq.Producer
+	lookupdAddr string
+	channel     string
+	logger      logging.Logger
+
+	mu        sync.Mutex
+	consumers []*nsq.Consumer
+}
+
+// NewNSQBus wraps an existing producer. lookupdAddr is the nsqlookupd
+// address consumers connect to when Subscribe is called; channel names
+// the NSQ channel subscribers read from, so multiple processes calling
+// Subscribe on the same topic form a single competing-consumer group
+// rather than each receiving every event.
+func NewNSQBus(producer *nsq.Producer, lookupdAddr, channel string, logger logging.Logger) *NSQBus {
+	return &NSQBus{
+		producer:    producer,
+		lookupdAddr: lookupdAddr,
+		channel:     channel,
+		logger:      logger,
+	}
+}
+
+// Publish marshals evt.Payload as JSON and publishes it to the NSQ topic
+// named evt.Topic.
+func (b *NSQBus) Publish(ctx context.Context, evt Event) error {
+	data, err := json.Marshal(evt.Payload)
+	if err != nil {
+		return fmt.Errorf("marshal event payload: %w", err)
+	}
+	if err := b.producer.Publish(evt.Topic, data); err != nil {
+		return fmt.Errorf("publish to nsq: %w", err)
+	}
+	return nil
+}
+
+// Subscribe starts an NSQ consumer for topic and calls handler for every
+// message received on it, with the message body delivered as the Event's
+// Payload in the form of a json.RawMessage. Subscribe errors are logged
+// rather than returned, matching the Bus interface.
+func (b *NSQBus) Subscribe(topic string, handler Handler) {
+	consumer, err := nsq.NewConsumer(topic, b.channel, nsq.NewConfig())
+	if err != nil {
+		b.logger.Error(context.Background(), "create nsq consumer", logging.F("topic", topic), logging.F("error", err))
+		return
+	}
+
+	consumer.AddHandler(nsq.HandlerFunc(func(msg *nsq.Message) error {
+		return handler(context.Background(), Event{Topic: topic, Payload: json.RawMessage(msg.Body)})
+	}))
+
+	if err := consumer.ConnectToNSQLookupd(b.lookupdAddr); err != nil {
+		b.logger.Error(context.Background(), "connect nsq consumer", logging.F("topic", topic), logging.F("error", err))
+		return
+	}
+
+	b.mu.Lock()
+	b.consumers = append(b.consumers, consumer)
+	b.mu.Unlock()
+}
+
+// Close stops every consumer started by Subscribe.
+func (b *NSQBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, consumer := range b.consumers {
+		consumer.Stop()
+	}
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// RetryConfig controls the exponential backoff applied by Retry.
+type RetryConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// DefaultRetryConfig retries a handler up to 3 times, waiting 100ms after
+// the first failure and doubling the wait after each subsequent one.
+var DefaultRetryConfig = RetryConfig{MaxAttempts: 3, BaseDelay: 100 * time.Millisecond}
+
+// Retry wraps handler so a failing call is retried with exponential
+// backoff (BaseDelay, 2*BaseDelay, 4*BaseDelay, ...) up to cfg.MaxAttempts
+// times before the final error is returned. It stops early if ctx is
+// canceled while waiting between attempts.
+func Retry(handler Handler, cfg RetryConfig) Handler {
+	return func(ctx context.Context, evt Event) error {
+		delay := cfg.BaseDelay
+		var err error
+		for attempt := 1; attempt <= cfg.MaxAttempts; attempt++ {
+			if err = handler(ctx, evt); err == nil {
+				return nil
+			}
+			if attempt == cfg.MaxAttempts {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+		return err
+	}
+}
@@ -0,0 +1,15 @@
+package logging
+
+import (
+	"context"
+
+	"analyzer/requestid"
+)
+
+func requestIDFromContext(ctx context.Context) (string, bool) {
+	return requestid.RequestID(ctx)
+}
+
+func userIDFromContext(ctx context.Context) (string, bool) {
+	return requestid.UserID(ctx)
+}
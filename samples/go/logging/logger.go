@@ -0,0 +1,66 @@
+// Package logging provides a structured, leveled logger whose entries
+// automatically carry the request and user IDs propagated through a
+// context.Context via the requestid package.
+package logging
+
+import "context"
+
+// Level is a log severity.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the level's lowercase name.
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Field is a structured key-value pair attached to a log entry.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// F creates a Field.
+func F(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Logger logs leveled, structured messages. Every method takes a context
+// so implementations can pull request and user IDs out of it via the
+// requestid package.
+type Logger interface {
+	Debug(ctx context.Context, msg string, fields ...Field)
+	Info(ctx context.Context, msg string, fields ...Field)
+	Warn(ctx context.Context, msg string, fields ...Field)
+	Error(ctx context.Context, msg string, fields ...Field)
+}
+
+// contextFields pulls the request and user IDs carried by ctx (if any)
+// into Fields, so every implementation attaches them the same way.
+func contextFields(ctx context.Context) []Field {
+	var fields []Field
+	if id, ok := requestIDFromContext(ctx); ok {
+		fields = append(fields, F("request_id", id))
+	}
+	if id, ok := userIDFromContext(ctx); ok {
+		fields = append(fields, F("user_id", id))
+	}
+	return fields
+}
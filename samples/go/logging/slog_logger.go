@@ -0,0 +1,43 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+)
+
+// SlogLogger writes structured JSON log lines via log/slog. It's intended
+// for production, where logs are shipped to a collector that parses JSON.
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger creates a SlogLogger writing JSON to stdout.
+func NewSlogLogger() *SlogLogger {
+	return &SlogLogger{logger: slog.New(slog.NewJSONHandler(os.Stdout, nil))}
+}
+
+func (l *SlogLogger) Debug(ctx context.Context, msg string, fields ...Field) {
+	l.logger.Log(ctx, slog.LevelDebug, msg, toAttrs(ctx, fields)...)
+}
+
+func (l *SlogLogger) Info(ctx context.Context, msg string, fields ...Field) {
+	l.logger.Log(ctx, slog.LevelInfo, msg, toAttrs(ctx, fields)...)
+}
+
+func (l *SlogLogger) Warn(ctx context.Context, msg string, fields ...Field) {
+	l.logger.Log(ctx, slog.LevelWarn, msg, toAttrs(ctx, fields)...)
+}
+
+func (l *SlogLogger) Error(ctx context.Context, msg string, fields ...Field) {
+	l.logger.Log(ctx, slog.LevelError, msg, toAttrs(ctx, fields)...)
+}
+
+func toAttrs(ctx context.Context, fields []Field) []any {
+	all := append(contextFields(ctx), fields...)
+	attrs := make([]any, 0, len(all))
+	for _, f := range all {
+		attrs = append(attrs, slog.Any(f.Key, f.Value))
+	}
+	return attrs
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// TextLogger writes plain, human-readable log lines. It's the default for
+// local development.
+type TextLogger struct {
+	out io.Writer
+}
+
+// NewTextLogger creates a TextLogger writing to stdout.
+func NewTextLogger() *TextLogger {
+	return &TextLogger{out: os.Stdout}
+}
+
+func (l *TextLogger) Debug(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, LevelDebug, msg, fields)
+}
+
+func (l *TextLogger) Info(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, LevelInfo, msg, fields)
+}
+
+func (l *TextLogger) Warn(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, LevelWarn, msg, fields)
+}
+
+func (l *TextLogger) Error(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, LevelError, msg, fields)
+}
+
+func (l *TextLogger) log(ctx context.Context, level Level, msg string, fields []Field) {
+	all := append(contextFields(ctx), fields...)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", strings.ToUpper(level.String()), msg)
+	for _, f := range all {
+		fmt.Fprintf(&b, " %s=%v", f.Key, f.Value)
+	}
+	fmt.Fprintln(l.out, b.String())
+}
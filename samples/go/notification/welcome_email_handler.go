@@ -0,0 +1,58 @@
+// Package notification contains handlers that react to domain events by
+// delivering user-facing notifications.
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"analyzer/domain"
+	"analyzer/event"
+	"analyzer/logging"
+)
+
+// WelcomeEmailHandler sends a welcome email whenever a
+// domain.UserCreatedEvent is published. Subscribe it (optionally wrapped
+// in event.Retry) to domain.UserCreatedTopic on an event.Bus to decouple
+// user creation from email delivery.
+type WelcomeEmailHandler struct {
+	logger logging.Logger
+}
+
+// NewWelcomeEmailHandler creates a new WelcomeEmailHandler.
+func NewWelcomeEmailHandler(logger logging.Logger) *WelcomeEmailHandler {
+	return &WelcomeEmailHandler{logger: logger}
+}
+
+// Handle sends the welcome email. It satisfies event.Handler, so it can
+// be passed directly to event.Bus.Subscribe.
+func (h *WelcomeEmailHandler) Handle(ctx context.Context, evt event.Event) error {
+	created, err := decodeUserCreated(evt.Payload)
+	if err != nil {
+		return fmt.Errorf("decode %s event: %w", evt.Topic, err)
+	}
+
+	h.logger.Info(ctx, "sending welcome email", logging.F("user_id", created.UserID), logging.F("email", created.Email))
+	// Email sending logic would go here.
+	return nil
+}
+
+// decodeUserCreated recovers a domain.UserCreatedEvent from evt.Payload,
+// which is a concrete struct when published by an in-process event.Bus
+// and a json.RawMessage when published by a bus that crosses process
+// boundaries (e.g. event.NSQBus).
+func decodeUserCreated(payload any) (domain.UserCreatedEvent, error) {
+	switch p := payload.(type) {
+	case domain.UserCreatedEvent:
+		return p, nil
+	case json.RawMessage:
+		var created domain.UserCreatedEvent
+		if err := json.Unmarshal(p, &created); err != nil {
+			return domain.UserCreatedEvent{}, err
+		}
+		return created, nil
+	default:
+		return domain.UserCreatedEvent{}, fmt.Errorf("unexpected payload type %T", payload)
+	}
+}
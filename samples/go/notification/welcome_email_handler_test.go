@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"analyzer/domain"
+	"analyzer/event"
+	"analyzer/logging"
+)
+
+func TestWelcomeEmailHandler_HandleWithConcretePayload(t *testing.T) {
+	handler := NewWelcomeEmailHandler(logging.NewTextLogger())
+
+	evt := event.Event{
+		Topic:   domain.UserCreatedTopic,
+		Payload: domain.UserCreatedEvent{UserID: "user-1", FirstName: "Ada", Email: "ada@example.com"},
+	}
+	if err := handler.Handle(context.Background(), evt); err != nil {
+		t.Fatalf("Handle returned error: %v", err)
+	}
+}
+
+func TestWelcomeEmailHandler_HandleWithRawJSONPayload(t *testing.T) {
+	handler := NewWelcomeEmailHandler(logging.NewTextLogger())
+
+	body, err := json.Marshal(domain.UserCreatedEvent{UserID: "user-1", FirstName: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+
+	evt := event.Event{Topic: domain.UserCreatedTopic, Payload: json.RawMessage(body)}
+	if err := handler.Handle(context.Background(), evt); err != nil {
+		t.Fatalf("Handle returned error: %v", err)
+	}
+}
+
+func TestWelcomeEmailHandler_HandleWithUnexpectedPayload(t *testing.T) {
+	handler := NewWelcomeEmailHandler(logging.NewTextLogger())
+
+	evt := event.Event{Topic: domain.UserCreatedTopic, Payload: 42}
+	if err := handler.Handle(context.Background(), evt); err == nil {
+		t.Fatal("expected error for unexpected payload type")
+	}
+}
@@ -0,0 +1,44 @@
+// Package requestid carries request and user identifiers through a
+// context.Context so both the gRPC and HTTP transports can attach the
+// same fields to their logs.
+package requestid
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	userIDKey
+)
+
+// New generates a fresh request ID.
+func New() string {
+	return ulid.Make().String()
+}
+
+// WithRequestID returns a copy of ctx carrying the given request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the request ID carried by ctx, if any.
+func RequestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey).(string)
+	return id, ok
+}
+
+// WithUserID returns a copy of ctx carrying the given authenticated user ID.
+func WithUserID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userIDKey, id)
+}
+
+// UserID returns the authenticated user ID carried by ctx, if any.
+func UserID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(userIDKey).(string)
+	return id, ok
+}
@@ -0,0 +1,29 @@
+package requestid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequestID_RoundTrip(t *testing.T) {
+	ctx := WithRequestID(context.Background(), "req-1")
+	id, ok := RequestID(ctx)
+	if !ok || id != "req-1" {
+		t.Fatalf("RequestID() = (%q, %v), want (\"req-1\", true)", id, ok)
+	}
+}
+
+func TestRequestID_Missing(t *testing.T) {
+	_, ok := RequestID(context.Background())
+	if ok {
+		t.Fatal("expected no request ID in a bare context")
+	}
+}
+
+func TestUserID_RoundTrip(t *testing.T) {
+	ctx := WithUserID(context.Background(), "user-1")
+	id, ok := UserID(ctx)
+	if !ok || id != "user-1" {
+		t.Fatalf("UserID() = (%q, %v), want (\"user-1\", true)", id, ok)
+	}
+}
@@ -0,0 +1,97 @@
+//go:build generated
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"analyzer/auth"
+	"analyzer/logging"
+	userv1 "analyzer/pkg/gen/user/v1"
+	"analyzer/requestid"
+)
+
+// requestIDInterceptor assigns a request ID to the context (reusing one
+// supplied via the "x-request-id" metadata key when present) and logs it
+// alongside the method name.
+func requestIDInterceptor(logger logging.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := requestid.New()
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-request-id"); len(values) > 0 && values[0] != "" {
+				id = values[0]
+			}
+		}
+		ctx = requestid.WithRequestID(ctx, id)
+		logger.Info(ctx, info.FullMethod, logging.F("request_id", id))
+		return handler(ctx, req)
+	}
+}
+
+// recoveryInterceptor turns a panic in the handler into an Internal
+// status error instead of crashing the server.
+func recoveryInterceptor(logger logging.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error(ctx, "panic handling request", logging.F("method", info.FullMethod), logging.F("panic", r))
+				err = status.Errorf(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// bearerToken strips a leading "Bearer " scheme from an Authorization
+// value, if present, so callers sending the standard
+// "Authorization: Bearer <token>" header validate correctly.
+func bearerToken(value string) string {
+	const prefix = "Bearer "
+	if len(value) > len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+		return value[len(prefix):]
+	}
+	return value
+}
+
+// authInterceptor validates the bearer token on every method except
+// CreateUser, which must remain reachable to register a new account.
+func authInterceptor(authService *auth.AuthService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == "/user.v1.UserService/CreateUser" {
+			return handler(ctx, req)
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+		tokens := md.Get("authorization")
+		if len(tokens) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization token")
+		}
+
+		user, err := authService.ValidateToken(ctx, bearerToken(tokens[0]))
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid authorization token")
+		}
+		return handler(requestid.WithUserID(ctx, user.ID), req)
+	}
+}
+
+// NewGRPCServer wires up a grpc.Server with request-ID logging, panic
+// recovery, and auth-token validation applied to every call.
+func NewGRPCServer(userServer *Server, authService *auth.AuthService, logger logging.Logger) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		recoveryInterceptor(logger),
+		requestIDInterceptor(logger),
+		authInterceptor(authService),
+	))
+	userv1.RegisterUserServiceServer(server, userServer)
+	return server
+}
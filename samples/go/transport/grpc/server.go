@@ -0,0 +1,133 @@
+//go:build generated
+
+// Package grpc exposes the usecase layer over gRPC. Message types come
+// from pkg/gen/user/v1, generated via `buf generate` from
+// proto/user/v1/user.proto (see buf.gen.yaml).
+//
+// This package is gated behind the "generated" build tag because
+// pkg/gen/user/v1 is a build artifact, not checked into version control
+// (see the /samples/go/pkg/gen/ entry in .gitignore). CI runs
+// `buf generate` and then `go build -tags generated ./...` so the
+// package only compiles once the stubs it depends on exist; a plain
+// `go build ./...` (and this repo's default verification) skips it.
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"analyzer/domain"
+	userv1 "analyzer/pkg/gen/user/v1"
+	"analyzer/usecase"
+)
+
+// Server implements userv1.UserServiceServer on top of the usecase layer.
+type Server struct {
+	userv1.UnimplementedUserServiceServer
+
+	saveUser   *usecase.SaveUserUseCase
+	findUser   *usecase.FindUserUseCase
+	updateUser *usecase.UpdateUserUseCase
+	deleteUser *usecase.DeleteUserUseCase
+	listUsers  *usecase.ListUsersUseCase
+}
+
+// NewServer creates a new Server.
+func NewServer(
+	saveUser *usecase.SaveUserUseCase,
+	findUser *usecase.FindUserUseCase,
+	updateUser *usecase.UpdateUserUseCase,
+	deleteUser *usecase.DeleteUserUseCase,
+	listUsers *usecase.ListUsersUseCase,
+) *Server {
+	return &Server{
+		saveUser:   saveUser,
+		findUser:   findUser,
+		updateUser: updateUser,
+		deleteUser: deleteUser,
+		listUsers:  listUsers,
+	}
+}
+
+// CreateUser creates a new user.
+func (s *Server) CreateUser(ctx context.Context, req *userv1.CreateUserRequest) (*userv1.User, error) {
+	user, err := s.saveUser.Execute(ctx, usecase.SaveUserDto{
+		FirstName: req.GetFirstName(),
+		LastName:  req.GetLastName(),
+		Email:     req.GetEmail(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoUser(user), nil
+}
+
+// GetUser retrieves a user by ID.
+func (s *Server) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.User, error) {
+	user, err := s.findUser.Execute(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoUser(user), nil
+}
+
+// UpdateUser updates an existing user.
+func (s *Server) UpdateUser(ctx context.Context, req *userv1.UpdateUserRequest) (*userv1.User, error) {
+	user, err := s.updateUser.Execute(ctx, usecase.UpdateUserDto{
+		ID:        req.GetId(),
+		FirstName: req.GetFirstName(),
+		LastName:  req.GetLastName(),
+		Email:     req.GetEmail(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoUser(user), nil
+}
+
+// DeleteUser removes a user.
+func (s *Server) DeleteUser(ctx context.Context, req *userv1.DeleteUserRequest) (*userv1.DeleteUserResponse, error) {
+	if err := s.deleteUser.Execute(ctx, req.GetId()); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &userv1.DeleteUserResponse{}, nil
+}
+
+// ListUsers returns a page of users.
+func (s *Server) ListUsers(ctx context.Context, req *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error) {
+	result, err := s.listUsers.Execute(ctx, usecase.ListUsersDto{
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	users := make([]*userv1.User, 0, len(result.Users))
+	for _, user := range result.Users {
+		users = append(users, toProtoUser(user))
+	}
+	return &userv1.ListUsersResponse{Users: users, Total: int32(result.Total)}, nil
+}
+
+func toProtoUser(user *usecase.UserDto) *userv1.User {
+	return &userv1.User{
+		Id:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	}
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,60 @@
+// Package http exposes the usecase layer over REST, using chi for routing.
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"analyzer/auth"
+	"analyzer/logging"
+	"analyzer/transport/middleware"
+	"analyzer/usecase"
+)
+
+// Server is the REST API for users.
+type Server struct {
+	saveUser   *usecase.SaveUserUseCase
+	findUser   *usecase.FindUserUseCase
+	updateUser *usecase.UpdateUserUseCase
+	deleteUser *usecase.DeleteUserUseCase
+	listUsers  *usecase.ListUsersUseCase
+}
+
+// NewServer creates a new Server.
+func NewServer(
+	saveUser *usecase.SaveUserUseCase,
+	findUser *usecase.FindUserUseCase,
+	updateUser *usecase.UpdateUserUseCase,
+	deleteUser *usecase.DeleteUserUseCase,
+	listUsers *usecase.ListUsersUseCase,
+) *Server {
+	return &Server{
+		saveUser:   saveUser,
+		findUser:   findUser,
+		updateUser: updateUser,
+		deleteUser: deleteUser,
+		listUsers:  listUsers,
+	}
+}
+
+// Router builds the chi router, wiring request-ID logging and panic
+// recovery on every route and auth-token validation on everything except
+// user creation.
+func (s *Server) Router(authService *auth.AuthService, logger logging.Logger) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.Recover(logger))
+	r.Use(middleware.RequestID(logger))
+
+	r.Post("/users", s.handleCreateUser)
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Auth(authService))
+		r.Get("/users", s.handleListUsers)
+		r.Get("/users/{id}", s.handleGetUser)
+		r.Put("/users/{id}", s.handleUpdateUser)
+		r.Delete("/users/{id}", s.handleDeleteUser)
+	})
+
+	return r
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"analyzer/auth"
+	"analyzer/domain"
+	"analyzer/event"
+	"analyzer/logging"
+	"analyzer/usecase"
+)
+
+// fakeUserRepository is a minimal domain.UserRepository used to exercise
+// the HTTP transport without a real database.
+type fakeUserRepository struct {
+	users map[string]*domain.User
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{users: make(map[string]*domain.User)}
+}
+
+func (r *fakeUserRepository) FindByID(id string) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("find user %s: %w", id, domain.ErrUserNotFound)
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) FindByEmail(email string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("find user with email %s: %w", email, domain.ErrUserNotFound)
+}
+
+func (r *fakeUserRepository) Save(user *domain.User) error {
+	r.users[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepository) Delete(id string) error {
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("delete user %s: %w", id, domain.ErrUserNotFound)
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func (r *fakeUserRepository) List(offset, limit int) ([]*domain.User, int, error) {
+	all := make([]*domain.User, 0, len(r.users))
+	for _, user := range r.users {
+		all = append(all, user)
+	}
+	total := len(all)
+	if offset >= total {
+		return []*domain.User{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total, nil
+}
+
+func newTestRouter() (http.Handler, *fakeUserRepository) {
+	repo := newFakeUserRepository()
+	logger := logging.NewTextLogger()
+	service := domain.NewUserService(repo, logger, event.NewInMemoryBus())
+	authService := auth.NewAuthService(repo, auth.NewInMemorySessionStore(), logger, auth.Config{
+		SigningKey: []byte("test-signing-key"),
+		TokenTTL:   time.Hour,
+	})
+
+	server := NewServer(
+		usecase.NewSaveUserUseCase(service),
+		usecase.NewFindUserUseCase(service),
+		usecase.NewUpdateUserUseCase(service),
+		usecase.NewDeleteUserUseCase(service),
+		usecase.NewListUsersUseCase(service),
+	)
+	return server.Router(authService, logger), repo
+}
+
+func TestHandleCreateUser(t *testing.T) {
+	router, _ := newTestRouter()
+
+	body := strings.NewReader(`{"first_name":"Ada","last_name":"Lovelace","email":"ada@example.com"}`)
+	req := httptest.NewRequest(http.MethodPost, "/users", body)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d; body = %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var got usecase.UserDto
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want %q", got.Email, "ada@example.com")
+	}
+}
+
+func TestHandleGetUser_RequiresAuth(t *testing.T) {
+	router, repo := newTestRouter()
+	repo.users["user-1"] = &domain.User{ID: "user-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+
+	req := httptest.NewRequest(http.MethodGet, "/users/user-1", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestHandleGetUser_AuthWithBearerPrefix(t *testing.T) {
+	repo := newFakeUserRepository()
+	logger := logging.NewTextLogger()
+	service := domain.NewUserService(repo, logger, event.NewInMemoryBus())
+	authService := auth.NewAuthService(repo, auth.NewInMemorySessionStore(), logger, auth.Config{
+		SigningKey: []byte("test-signing-key"),
+		TokenTTL:   time.Hour,
+	})
+	server := NewServer(
+		usecase.NewSaveUserUseCase(service),
+		usecase.NewFindUserUseCase(service),
+		usecase.NewUpdateUserUseCase(service),
+		usecase.NewDeleteUserUseCase(service),
+		usecase.NewListUsersUseCase(service),
+	)
+	router := server.Router(authService, logger)
+
+	user, err := authService.Register(context.Background(), "Ada", "Lovelace", "ada@example.com", "hunter2")
+	if err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+	token, err := authService.Login(context.Background(), "ada@example.com", "hunter2")
+	if err != nil {
+		t.Fatalf("Login returned error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+user.ID, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+func TestHandleGetUser_NotFound(t *testing.T) {
+	router, _ := newTestRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/users/missing", nil)
+	req.Header.Set("Authorization", "irrelevant")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d (invalid token is rejected before reaching the handler)", rec.Code, http.StatusUnauthorized)
+	}
+}
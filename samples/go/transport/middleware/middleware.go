@@ -0,0 +1,81 @@
+// Package middleware provides chi-compatible HTTP middleware shared by
+// the REST transport: request-ID logging, panic recovery, and auth-token
+// validation.
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"analyzer/auth"
+	"analyzer/logging"
+	"analyzer/requestid"
+)
+
+// RequestID assigns a request ID (reusing the X-Request-ID header when
+// the caller supplied one), attaches it to the request context, and logs
+// the method and path alongside it.
+func RequestID(logger logging.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get("X-Request-Id")
+			if id == "" {
+				id = requestid.New()
+			}
+			ctx := requestid.WithRequestID(r.Context(), id)
+			w.Header().Set("X-Request-Id", id)
+			logger.Info(ctx, "request received", logging.F("method", r.Method), logging.F("path", r.URL.Path))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Recover turns a panic in a downstream handler into a 500 response
+// instead of crashing the server.
+func Recover(logger logging.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					logger.Error(r.Context(), "panic handling request",
+						logging.F("method", r.Method), logging.F("path", r.URL.Path), logging.F("panic", rec))
+					http.Error(w, "internal server error", http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerToken strips a leading "Bearer " scheme from an Authorization
+// header value, if present, so clients sending the standard
+// "Authorization: Bearer <token>" header validate correctly.
+func bearerToken(value string) string {
+	const prefix = "Bearer "
+	if len(value) > len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+		return value[len(prefix):]
+	}
+	return value
+}
+
+// Auth validates the bearer token on the request and attaches the
+// authenticated user ID to the context.
+func Auth(authService *auth.AuthService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get("Authorization")
+			if token == "" {
+				http.Error(w, "missing authorization token", http.StatusUnauthorized)
+				return
+			}
+
+			user, err := authService.ValidateToken(r.Context(), bearerToken(token))
+			if err != nil {
+				http.Error(w, "invalid authorization token", http.StatusUnauthorized)
+				return
+			}
+			ctx := requestid.WithUserID(r.Context(), user.ID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
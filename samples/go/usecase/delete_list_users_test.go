@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"analyzer/domain"
+	"analyzer/event"
+	"analyzer/logging"
+)
+
+func TestDeleteUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeUserRepository()
+	service := domain.NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+	saved, err := service.CreateUser(ctx, "Ada", "Lovelace", "ada@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+
+	uc := NewDeleteUserUseCase(service)
+	if err := uc.Execute(ctx, saved.ID); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if _, ok := repo.users[saved.ID]; ok {
+		t.Errorf("user %s was not deleted", saved.ID)
+	}
+}
+
+func TestListUsersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeUserRepository()
+	service := domain.NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		if _, err := service.CreateUser(ctx, "First", "Last", email); err != nil {
+			t.Fatalf("CreateUser returned error: %v", err)
+		}
+	}
+
+	uc := NewListUsersUseCase(service)
+	result, err := uc.Execute(ctx, ListUsersDto{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if result.Total != 3 {
+		t.Errorf("Total = %d, want 3", result.Total)
+	}
+	if len(result.Users) != 2 {
+		t.Errorf("len(Users) = %d, want 2", len(result.Users))
+	}
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"analyzer/domain"
+)
+
+// DeleteUserUseCase removes users.
+type DeleteUserUseCase struct {
+	service *domain.UserService
+}
+
+// NewDeleteUserUseCase creates a new DeleteUserUseCase.
+func NewDeleteUserUseCase(service *domain.UserService) *DeleteUserUseCase {
+	return &DeleteUserUseCase{service: service}
+}
+
+// Execute deletes the user with the given ID.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, id string) error {
+	return uc.service.DeleteUser(ctx, id)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"time"
+
+	"analyzer/domain"
+)
+
+// SaveUserDto carries the fields needed to create a new user.
+type SaveUserDto struct {
+	FirstName string
+	LastName  string
+	Email     string
+}
+
+// UpdateUserDto carries the fields needed to update an existing user.
+type UpdateUserDto struct {
+	ID        string
+	FirstName string
+	LastName  string
+	Email     string
+}
+
+// UserDto is the read-facing representation of a user, decoupled from the
+// domain entity so callers don't depend on storage details.
+type UserDto struct {
+	ID        string
+	FirstName string
+	LastName  string
+	Email     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ListUsersDto carries pagination parameters for ListUsersUseCase.
+type ListUsersDto struct {
+	Page     int
+	PageSize int
+}
+
+// ListUsersResult is a page of users along with the total number available,
+// so callers can compute how many pages remain.
+type ListUsersResult struct {
+	Users []*UserDto
+	Total int
+}
+
+func toUserDto(user *domain.User) *UserDto {
+	return &UserDto{
+		ID:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
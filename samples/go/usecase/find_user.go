@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"analyzer/domain"
+)
+
+// FindUserUseCase retrieves users by ID.
+type FindUserUseCase struct {
+	service *domain.UserService
+}
+
+// NewFindUserUseCase creates a new FindUserUseCase.
+func NewFindUserUseCase(service *domain.UserService) *FindUserUseCase {
+	return &FindUserUseCase{service: service}
+}
+
+// Execute retrieves the user with the given ID.
+func (uc *FindUserUseCase) Execute(ctx context.Context, id string) (*UserDto, error) {
+	user, err := uc.service.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toUserDto(user), nil
+}
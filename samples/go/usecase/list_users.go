@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"analyzer/domain"
+)
+
+const defaultPageSize = 20
+
+// ListUsersUseCase returns a page of users.
+type ListUsersUseCase struct {
+	service *domain.UserService
+}
+
+// NewListUsersUseCase creates a new ListUsersUseCase.
+func NewListUsersUseCase(service *domain.UserService) *ListUsersUseCase {
+	return &ListUsersUseCase{service: service}
+}
+
+// Execute returns the requested page of users. Page numbers start at 1; a
+// non-positive page or page size falls back to sane defaults.
+func (uc *ListUsersUseCase) Execute(ctx context.Context, dto ListUsersDto) (*ListUsersResult, error) {
+	page := dto.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := dto.PageSize
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+
+	users, total, err := uc.service.ListUsers(ctx, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*UserDto, 0, len(users))
+	for _, user := range users {
+		dtos = append(dtos, toUserDto(user))
+	}
+	return &ListUsersResult{Users: dtos, Total: total}, nil
+}
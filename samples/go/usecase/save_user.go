@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"analyzer/domain"
+)
+
+// SaveUserUseCase creates new users.
+type SaveUserUseCase struct {
+	service *domain.UserService
+}
+
+// NewSaveUserUseCase creates a new SaveUserUseCase.
+func NewSaveUserUseCase(service *domain.UserService) *SaveUserUseCase {
+	return &SaveUserUseCase{service: service}
+}
+
+// Execute creates a user from the given DTO.
+func (uc *SaveUserUseCase) Execute(ctx context.Context, dto SaveUserDto) (*UserDto, error) {
+	user, err := uc.service.CreateUser(ctx, dto.FirstName, dto.LastName, dto.Email)
+	if err != nil {
+		return nil, err
+	}
+	return toUserDto(user), nil
+}
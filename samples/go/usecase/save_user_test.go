@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"analyzer/domain"
+	"analyzer/event"
+	"analyzer/logging"
+)
+
+// fakeUserRepository is a minimal domain.UserRepository used to exercise
+// the usecase layer without a real database.
+type fakeUserRepository struct {
+	users map[string]*domain.User
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{users: make(map[string]*domain.User)}
+}
+
+func (r *fakeUserRepository) FindByID(id string) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("find user %s: %w", id, domain.ErrUserNotFound)
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) FindByEmail(email string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("find user with email %s: %w", email, domain.ErrUserNotFound)
+}
+
+func (r *fakeUserRepository) Save(user *domain.User) error {
+	r.users[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepository) Delete(id string) error {
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("delete user %s: %w", id, domain.ErrUserNotFound)
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func (r *fakeUserRepository) List(offset, limit int) ([]*domain.User, int, error) {
+	all := make([]*domain.User, 0, len(r.users))
+	for _, user := range r.users {
+		all = append(all, user)
+	}
+	total := len(all)
+	if offset >= total {
+		return []*domain.User{}, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total, nil
+}
+
+func TestSaveUserUseCase_Execute(t *testing.T) {
+	repo := newFakeUserRepository()
+	service := domain.NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+	uc := NewSaveUserUseCase(service)
+
+	result, err := uc.Execute(context.Background(), SaveUserDto{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if result.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want %q", result.Email, "ada@example.com")
+	}
+}
+
+func TestFindUserUseCase_Execute(t *testing.T) {
+	repo := newFakeUserRepository()
+	service := domain.NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+	saved, err := service.CreateUser(context.Background(), "Ada", "Lovelace", "ada@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+
+	uc := NewFindUserUseCase(service)
+	result, err := uc.Execute(context.Background(), saved.ID)
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if result.FirstName != "Ada" {
+		t.Errorf("FirstName = %q, want %q", result.FirstName, "Ada")
+	}
+}
+
+func TestUpdateUserUseCase_Execute(t *testing.T) {
+	repo := newFakeUserRepository()
+	service := domain.NewUserService(repo, logging.NewTextLogger(), event.NewInMemoryBus())
+	saved, err := service.CreateUser(context.Background(), "Ada", "Lovelace", "ada@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+
+	uc := NewUpdateUserUseCase(service)
+	result, err := uc.Execute(context.Background(), UpdateUserDto{ID: saved.ID, FirstName: "Ada", LastName: "King", Email: "ada.king@example.com"})
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if result.LastName != "King" {
+		t.Errorf("LastName = %q, want %q", result.LastName, "King")
+	}
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"analyzer/domain"
+)
+
+// UpdateUserUseCase updates existing users.
+type UpdateUserUseCase struct {
+	service *domain.UserService
+}
+
+// NewUpdateUserUseCase creates a new UpdateUserUseCase.
+func NewUpdateUserUseCase(service *domain.UserService) *UpdateUserUseCase {
+	return &UpdateUserUseCase{service: service}
+}
+
+// Execute updates a user from the given DTO.
+func (uc *UpdateUserUseCase) Execute(ctx context.Context, dto UpdateUserDto) (*UserDto, error) {
+	user, err := uc.service.UpdateUser(ctx, dto.ID, dto.FirstName, dto.LastName, dto.Email)
+	if err != nil {
+		return nil, err
+	}
+	return toUserDto(user), nil
+}